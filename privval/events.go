@@ -0,0 +1,80 @@
+package privval
+
+// EventType identifies the kind of connection-level occurrence carried by an
+// Event.
+type EventType int
+
+const (
+	// EventConnected is emitted when a new signer connection is accepted.
+	EventConnected EventType = iota
+	// EventDisconnected is emitted when a signer connection is closed,
+	// whether because it failed a ping/request or the remote end hung up.
+	EventDisconnected
+	// EventPromoted is emitted when a standby connection is promoted to
+	// primary after the previous primary was disconnected.
+	EventPromoted
+	// EventPingTimeout is emitted when a ping to a connection doesn't
+	// complete before its deadline.
+	EventPingTimeout
+	// EventReconnectFailed is emitted when the accept loop fails to
+	// establish a replacement connection.
+	EventReconnectFailed
+)
+
+func (t EventType) String() string {
+	switch t {
+	case EventConnected:
+		return "connected"
+	case EventDisconnected:
+		return "disconnected"
+	case EventPromoted:
+		return "promoted"
+	case EventPingTimeout:
+		return "ping_timeout"
+	case EventReconnectFailed:
+		return "reconnect_failed"
+	default:
+		return "unknown"
+	}
+}
+
+// Event describes one connection-level occurrence on a SignerListenerEndpoint.
+// ConnID is the affected connection's id, or 0 for endpoint-wide events (e.g.
+// EventReconnectFailed before any connection exists to attribute it to).
+type Event struct {
+	ConnID int
+	Type   EventType
+	Err    error
+}
+
+// defaultEventBacklog is how many unconsumed events Events() will buffer
+// before further emits are dropped, so a slow or absent observer can never
+// block the ping/accept loops.
+const defaultEventBacklog = 32
+
+// Events returns a channel of connection-state events (Connected,
+// Disconnected, Promoted, PingTimeout, ReconnectFailed) that higher layers
+// can observe instead of scraping logs. It replaces the Logger.Error(
+// "Ping", ...) path as the primary way to react to signer connectivity
+// changes; errors are still logged, but callers that need to act on a
+// transition (e.g. alerting, failover bookkeeping) should read from here.
+//
+// The returned channel is created once per endpoint and is never closed:
+// SendRequest/SendRequestContext can still be in flight on another goroutine
+// when the endpoint stops, and a send on a closed channel panics
+// unconditionally, so closing it here would race. Callers that need to know
+// when the endpoint itself has stopped should select on ve.Quit() (from
+// cmn.BaseService) alongside Events().
+func (ve *SignerListenerEndpoint) Events() <-chan Event {
+	return ve.eventCh
+}
+
+// emitEvent delivers an event to any Events() observer without blocking the
+// caller; if the buffer is full the event is dropped and logged instead.
+func (ve *SignerListenerEndpoint) emitEvent(ev Event) {
+	select {
+	case ve.eventCh <- ev:
+	default:
+		ve.Logger.Error("dropped event: observer channel full", "type", ev.Type.String(), "connID", ev.ConnID)
+	}
+}
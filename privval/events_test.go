@@ -0,0 +1,42 @@
+package privval
+
+import (
+	"net"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/tendermint/tendermint/libs/log"
+)
+
+// TestEventsChannelSurvivesStop guards against reintroducing close(ve.eventCh)
+// in OnStop: a send on a closed channel panics unconditionally, and
+// markUnhealthy (reachable from a SendRequest racing with shutdown) can still
+// call emitEvent after Stop returns. The channel must stay open and simply
+// absorb or drop events instead.
+func TestEventsChannelSurvivesStop(t *testing.T) {
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+
+	ve := NewSignerListenerEndpoint(log.TestingLogger(), ln)
+
+	dialed := make(chan struct{})
+	go func() {
+		conn, err := net.Dial("tcp", ln.Addr().String())
+		if err == nil {
+			defer conn.Close()
+		}
+		close(dialed)
+		time.Sleep(time.Second)
+	}()
+
+	require.NoError(t, ve.Start())
+	<-dialed
+	ve.Stop()
+
+	assert.NotPanics(t, func() {
+		ve.emitEvent(Event{Type: EventDisconnected})
+	})
+}
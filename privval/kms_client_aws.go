@@ -0,0 +1,67 @@
+// +build kms
+
+package privval
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/config"
+	"github.com/aws/aws-sdk-go-v2/service/kms"
+	"github.com/aws/aws-sdk-go-v2/service/kms/types"
+
+	"github.com/tendermint/tendermint/crypto"
+)
+
+// awsKMSClient signs using an asymmetric ECC_SECG_P256K1 key in AWS KMS.
+type awsKMSClient struct {
+	svc   *kms.Client
+	keyID string
+}
+
+func newAWSKMSClient(keyID string) (kmsClient, error) {
+	cfg, err := config.LoadDefaultConfig(context.Background())
+	if err != nil {
+		return nil, fmt.Errorf("kms(aws): load config: %w", err)
+	}
+
+	return &awsKMSClient{
+		svc:   kms.NewFromConfig(cfg),
+		keyID: keyID,
+	}, nil
+}
+
+func (c *awsKMSClient) Sign(ctx context.Context, digest []byte) ([]byte, error) {
+	// digest is already a SHA-256 hash of the sign bytes (see KMSSigner.sign),
+	// so tell KMS not to hash it again.
+	out, err := c.svc.Sign(ctx, &kms.SignInput{
+		KeyId:            aws.String(c.keyID),
+		Message:          digest,
+		MessageType:      types.MessageTypeDigest,
+		SigningAlgorithm: types.SigningAlgorithmSpecEcdsaSha256,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return out.Signature, nil
+}
+
+func (c *awsKMSClient) GetPublicKey(ctx context.Context) (crypto.PubKey, error) {
+	out, err := c.svc.GetPublicKey(ctx, &kms.GetPublicKeyInput{
+		KeyId: aws.String(c.keyID),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return secp256k1PubKeyFromBytes(out.PublicKey)
+}
+
+func (c *awsKMSClient) Ping(ctx context.Context) error {
+	_, err := c.svc.DescribeKey(ctx, &kms.DescribeKeyInput{
+		KeyId: aws.String(c.keyID),
+	})
+	return err
+}
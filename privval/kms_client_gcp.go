@@ -0,0 +1,64 @@
+// +build kms
+
+package privval
+
+import (
+	"context"
+	"fmt"
+
+	kmspb "cloud.google.com/go/kms/apiv1/kmspb"
+	kms "cloud.google.com/go/kms/apiv1"
+
+	"github.com/tendermint/tendermint/crypto"
+)
+
+// gcpKMSClient signs using an asymmetric EC_SIGN_SECP256K1_SHA256 key
+// version in Google Cloud KMS.
+type gcpKMSClient struct {
+	client        *kms.KeyManagementClient
+	cryptoKeyPath string
+}
+
+func newGCPKMSClient(cryptoKeyPath string) (kmsClient, error) {
+	client, err := kms.NewKeyManagementClient(context.Background())
+	if err != nil {
+		return nil, fmt.Errorf("kms(gcp): new client: %w", err)
+	}
+
+	return &gcpKMSClient{
+		client:        client,
+		cryptoKeyPath: cryptoKeyPath,
+	}, nil
+}
+
+func (c *gcpKMSClient) Sign(ctx context.Context, digest []byte) ([]byte, error) {
+	resp, err := c.client.AsymmetricSign(ctx, &kmspb.AsymmetricSignRequest{
+		Name: c.cryptoKeyPath,
+		Digest: &kmspb.Digest{
+			Digest: &kmspb.Digest_Sha256{Sha256: digest},
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return resp.Signature, nil
+}
+
+func (c *gcpKMSClient) GetPublicKey(ctx context.Context) (crypto.PubKey, error) {
+	resp, err := c.client.GetPublicKey(ctx, &kmspb.GetPublicKeyRequest{
+		Name: c.cryptoKeyPath,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return parsePEMSecp256k1PubKey([]byte(resp.Pem))
+}
+
+func (c *gcpKMSClient) Ping(ctx context.Context) error {
+	_, err := c.client.GetCryptoKeyVersion(ctx, &kmspb.GetCryptoKeyVersionRequest{
+		Name: c.cryptoKeyPath,
+	})
+	return err
+}
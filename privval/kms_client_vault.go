@@ -0,0 +1,101 @@
+// +build kms
+
+package privval
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+	"strconv"
+	"strings"
+
+	vaultapi "github.com/hashicorp/vault/api"
+
+	"github.com/tendermint/tendermint/crypto"
+)
+
+// vaultTransitClient signs using a secp256k1-ecdsa key managed by Vault's
+// Transit secrets engine.
+type vaultTransitClient struct {
+	client  *vaultapi.Client
+	keyName string
+}
+
+func newVaultTransitClient(keyName string) (kmsClient, error) {
+	client, err := vaultapi.NewClient(vaultapi.DefaultConfig())
+	if err != nil {
+		return nil, fmt.Errorf("kms(vault): new client: %w", err)
+	}
+
+	return &vaultTransitClient{
+		client:  client,
+		keyName: keyName,
+	}, nil
+}
+
+func (c *vaultTransitClient) Sign(ctx context.Context, digest []byte) ([]byte, error) {
+	// "signature_algorithm" is an RSA-only Transit parameter (pkcs1v15 or
+	// pss); it doesn't apply to the secp256k1 ECDSA key this backend signs
+	// with, so it's omitted. "prehashed" tells Transit that input is already
+	// the SHA-256 digest KMSSigner.sign computed, not the raw sign bytes.
+	secret, err := c.client.Logical().WriteWithContext(ctx, "transit/sign/"+c.keyName, map[string]interface{}{
+		"input":     base64.StdEncoding.EncodeToString(digest),
+		"prehashed": true,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	sigField, ok := secret.Data["signature"].(string)
+	if !ok {
+		return nil, fmt.Errorf("kms(vault): malformed sign response")
+	}
+
+	// Vault returns "vault:v<version>:<base64-sig>".
+	parts := strings.SplitN(sigField, ":", 3)
+	if len(parts) != 3 {
+		return nil, fmt.Errorf("kms(vault): malformed signature %q", sigField)
+	}
+
+	return base64.StdEncoding.DecodeString(parts[2])
+}
+
+func (c *vaultTransitClient) GetPublicKey(ctx context.Context) (crypto.PubKey, error) {
+	secret, err := c.client.Logical().ReadWithContext(ctx, "transit/keys/"+c.keyName)
+	if err != nil {
+		return nil, err
+	}
+	if secret == nil {
+		return nil, fmt.Errorf("kms(vault): key %q not found", c.keyName)
+	}
+
+	keys, ok := secret.Data["keys"].(map[string]interface{})
+	if !ok || len(keys) == 0 {
+		return nil, fmt.Errorf("kms(vault): no key versions for %q", c.keyName)
+	}
+
+	// Vault's JSON API returns latest_version as a number, decoded here as a
+	// float64, not a string; map it into the keys map's string-keyed lookup.
+	latestVersion, ok := secret.Data["latest_version"].(float64)
+	if !ok {
+		return nil, fmt.Errorf("kms(vault): missing or malformed latest_version for %q", c.keyName)
+	}
+	latest := strconv.FormatFloat(latestVersion, 'f', -1, 64)
+
+	versionInfo, ok := keys[latest].(map[string]interface{})
+	if !ok {
+		return nil, fmt.Errorf("kms(vault): malformed key version data")
+	}
+
+	pubKeyPEM, ok := versionInfo["public_key"].(string)
+	if !ok {
+		return nil, fmt.Errorf("kms(vault): missing public_key for %q", c.keyName)
+	}
+
+	return parsePEMSecp256k1PubKey([]byte(pubKeyPEM))
+}
+
+func (c *vaultTransitClient) Ping(ctx context.Context) error {
+	_, err := c.client.Logical().ReadWithContext(ctx, "transit/keys/"+c.keyName)
+	return err
+}
@@ -0,0 +1,145 @@
+package privval
+
+import (
+	"context"
+	"errors"
+
+	"github.com/go-kit/kit/metrics"
+	"github.com/go-kit/kit/metrics/discard"
+	prometheus "github.com/go-kit/kit/metrics/prometheus"
+	stdprometheus "github.com/prometheus/client_golang/prometheus"
+)
+
+const (
+	// MetricsSubsystem is a unique prefix for metrics reported in this package.
+	MetricsSubsystem = "privval"
+)
+
+// Metrics contains the Prometheus-style metrics exposed by
+// SignerListenerEndpoint.
+type Metrics struct {
+	// ConnectionState is 1 while the endpoint has a healthy primary
+	// connection, 0 otherwise.
+	ConnectionState metrics.Gauge
+	// ReconnectCount counts every signer connection accepted after the
+	// initial one, whether filling a standby slot or replacing one that was
+	// lost.
+	ReconnectCount metrics.Counter
+	// PingTime is a histogram of round-trip time for ping requests.
+	PingTime metrics.Histogram
+	// SendRequestLatency is a histogram of SendRequest round-trip time,
+	// labeled by "message_type".
+	SendRequestLatency metrics.Histogram
+	// SignerErrors counts SendRequest and ping failures, labeled by
+	// "error_class".
+	SignerErrors metrics.Counter
+}
+
+// PrometheusMetrics returns Metrics built using Prometheus client metrics, as
+// used elsewhere in tendermint.
+func PrometheusMetrics(namespace string, labelsAndValues ...string) *Metrics {
+	labels := []string{}
+	for i := 0; i < len(labelsAndValues); i += 2 {
+		labels = append(labels, labelsAndValues[i])
+	}
+	return &Metrics{
+		ConnectionState: prometheus.NewGaugeFrom(stdprometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: MetricsSubsystem,
+			Name:      "connection_state",
+			Help:      "Whether the endpoint currently has a healthy primary signer connection (1) or not (0).",
+		}, labels).With(labelsAndValues...),
+		ReconnectCount: prometheus.NewCounterFrom(stdprometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: MetricsSubsystem,
+			Name:      "reconnect_count",
+			Help:      "Number of signer connections accepted after the initial one, whether filling a standby slot or replacing one that was lost.",
+		}, labels).With(labelsAndValues...),
+		PingTime: prometheus.NewHistogramFrom(stdprometheus.HistogramOpts{
+			Namespace: namespace,
+			Subsystem: MetricsSubsystem,
+			Name:      "ping_time_seconds",
+			Help:      "Round-trip time of ping requests to a signer connection.",
+		}, labels).With(labelsAndValues...),
+		SendRequestLatency: prometheus.NewHistogramFrom(stdprometheus.HistogramOpts{
+			Namespace: namespace,
+			Subsystem: MetricsSubsystem,
+			Name:      "send_request_latency_seconds",
+			Help:      "Round-trip time of SendRequest calls, by message type.",
+		}, append(labels, "message_type")).With(labelsAndValues...),
+		SignerErrors: prometheus.NewCounterFrom(stdprometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: MetricsSubsystem,
+			Name:      "signer_errors_total",
+			Help:      "Number of SendRequest and ping errors, by error class.",
+		}, append(labels, "error_class")).With(labelsAndValues...),
+	}
+}
+
+// errorClass buckets a SendRequest/ping error into a small, stable label
+// value suitable for a metrics dimension.
+func errorClass(err error) string {
+	switch {
+	case err == nil:
+		return "none"
+	case errors.Is(err, context.DeadlineExceeded):
+		return "timeout"
+	case errors.Is(err, context.Canceled):
+		return "canceled"
+	case err == ErrUnexpectedResponse:
+		return "unexpected_response"
+	case hasCause(err, ErrListenerTimeout):
+		return "listener_timeout"
+	default:
+		return "io"
+	}
+}
+
+// hasCause reports whether err is, or wraps (via a cmn.Error-style Cause()
+// chain), target. readMessage/writeMessage hand back
+// cmn.ErrorWrap(ErrListenerTimeout, ...), a distinct value from
+// ErrListenerTimeout itself, so a plain == comparison never matches it; this
+// walks the Cause() chain the same way cmn.Error's own callers do.
+func hasCause(err, target error) bool {
+	for err != nil {
+		if err == target {
+			return true
+		}
+		causer, ok := err.(interface{ Cause() error })
+		if !ok {
+			return false
+		}
+		err = causer.Cause()
+	}
+	return false
+}
+
+// messageType labels a RemoteSignerMsg by its concrete request type, for the
+// SendRequestLatency histogram.
+func messageType(request RemoteSignerMsg) string {
+	switch request.(type) {
+	case *PubKeyRequest:
+		return "pub_key"
+	case *SignVoteRequest:
+		return "sign_vote"
+	case *SignProposalRequest:
+		return "sign_proposal"
+	case *PingRequest:
+		return "ping"
+	default:
+		return "unknown"
+	}
+}
+
+// NopMetrics returns a Metrics that discards everything it is given. It is
+// the default for a SignerListenerEndpoint that hasn't been given metrics
+// via SignerValidatorEndpointSetMetrics.
+func NopMetrics() *Metrics {
+	return &Metrics{
+		ConnectionState:    discard.NewGauge(),
+		ReconnectCount:     discard.NewCounter(),
+		PingTime:           discard.NewHistogram(),
+		SendRequestLatency: discard.NewHistogram(),
+		SignerErrors:       discard.NewCounter(),
+	}
+}
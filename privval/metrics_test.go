@@ -0,0 +1,33 @@
+package privval
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	cmn "github.com/tendermint/tendermint/libs/common"
+)
+
+func TestErrorClass(t *testing.T) {
+	cases := []struct {
+		name string
+		err  error
+		want string
+	}{
+		{"nil", nil, "none"},
+		{"deadline exceeded", context.DeadlineExceeded, "timeout"},
+		{"canceled", context.Canceled, "canceled"},
+		{"unexpected response", ErrUnexpectedResponse, "unexpected_response"},
+		{"bare listener timeout", ErrListenerTimeout, "listener_timeout"},
+		{"wrapped listener timeout", cmn.ErrorWrap(ErrListenerTimeout, "i/o timeout"), "listener_timeout"},
+		{"other", errors.New("boom"), "io"},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.want, errorClass(tc.err))
+		})
+	}
+}
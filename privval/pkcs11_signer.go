@@ -0,0 +1,228 @@
+// +build pkcs11
+
+package privval
+
+import (
+	"encoding/asn1"
+	"fmt"
+	"sync"
+
+	"github.com/miekg/pkcs11"
+
+	"github.com/tendermint/tendermint/crypto"
+	"github.com/tendermint/tendermint/crypto/ed25519"
+	"github.com/tendermint/tendermint/types"
+)
+
+// PKCS11Config holds the parameters needed to open a session against a
+// PKCS#11 token (e.g. a YubiHSM or SoftHSM) and locate the validator key
+// inside it.
+type PKCS11Config struct {
+	// ModulePath is the path to the vendor's PKCS#11 shared library.
+	ModulePath string
+	// SlotID identifies the token slot that holds the key.
+	SlotID uint
+	// Pin authenticates the session with the token.
+	Pin string
+	// KeyLabel is the CKA_LABEL of the private key object to sign with.
+	KeyLabel string
+}
+
+// PKCS11Signer is a SignerBackend that signs votes and proposals using a key
+// held inside a PKCS#11 token, so the validator's consensus key never
+// touches the host's filesystem or memory in cleartext.
+type PKCS11Signer struct {
+	mtx sync.Mutex
+
+	ctx     *pkcs11.Ctx
+	session pkcs11.SessionHandle
+
+	privateKey pkcs11.ObjectHandle
+	pubKey     crypto.PubKey
+	mechanism  uint
+}
+
+// NewPKCS11Signer opens a session against the configured PKCS#11 module,
+// logs in, and locates the key identified by cfg.KeyLabel.
+func NewPKCS11Signer(cfg PKCS11Config) (*PKCS11Signer, error) {
+	ctx := pkcs11.New(cfg.ModulePath)
+	if ctx == nil {
+		return nil, fmt.Errorf("pkcs11: failed to load module %q", cfg.ModulePath)
+	}
+	if err := ctx.Initialize(); err != nil {
+		return nil, fmt.Errorf("pkcs11: initialize: %w", err)
+	}
+
+	session, err := ctx.OpenSession(cfg.SlotID, pkcs11.CKF_SERIAL_SESSION|pkcs11.CKF_RW_SESSION)
+	if err != nil {
+		return nil, fmt.Errorf("pkcs11: open session: %w", err)
+	}
+
+	if err := ctx.Login(session, pkcs11.CKU_USER, cfg.Pin); err != nil {
+		return nil, fmt.Errorf("pkcs11: login: %w", err)
+	}
+
+	privateKey, mechanism, err := findKeyAndMechanism(ctx, session, cfg.KeyLabel)
+	if err != nil {
+		return nil, err
+	}
+
+	signer := &PKCS11Signer{
+		ctx:        ctx,
+		session:    session,
+		privateKey: privateKey,
+		mechanism:  mechanism,
+	}
+
+	pubKey, err := signer.fetchPubKey(cfg.KeyLabel)
+	if err != nil {
+		return nil, err
+	}
+	signer.pubKey = pubKey
+
+	return signer, nil
+}
+
+// findKeyAndMechanism locates the private key object with the given label
+// and maps its key type to the PKCS#11 signing mechanism Tendermint expects:
+// CKM_EDDSA for ed25519 keys, CKM_ECDSA for secp256k1 keys.
+func findKeyAndMechanism(ctx *pkcs11.Ctx, session pkcs11.SessionHandle, label string) (pkcs11.ObjectHandle, uint, error) {
+	template := []*pkcs11.Attribute{
+		pkcs11.NewAttribute(pkcs11.CKA_CLASS, pkcs11.CKO_PRIVATE_KEY),
+		pkcs11.NewAttribute(pkcs11.CKA_LABEL, label),
+	}
+	if err := ctx.FindObjectsInit(session, template); err != nil {
+		return 0, 0, fmt.Errorf("pkcs11: find objects init: %w", err)
+	}
+	defer ctx.FindObjectsFinal(session)
+
+	objs, _, err := ctx.FindObjects(session, 1)
+	if err != nil {
+		return 0, 0, fmt.Errorf("pkcs11: find objects: %w", err)
+	}
+	if len(objs) == 0 {
+		return 0, 0, fmt.Errorf("pkcs11: no private key found with label %q", label)
+	}
+
+	attrs, err := ctx.GetAttributeValue(session, objs[0], []*pkcs11.Attribute{
+		pkcs11.NewAttribute(pkcs11.CKA_KEY_TYPE, nil),
+	})
+	if err != nil {
+		return 0, 0, fmt.Errorf("pkcs11: get key type: %w", err)
+	}
+
+	switch keyType := attrs[0].Value; {
+	case len(keyType) > 0 && keyType[0] == pkcs11.CKK_EC_EDWARDS:
+		return objs[0], pkcs11.CKM_EDDSA, nil
+	case len(keyType) > 0 && keyType[0] == pkcs11.CKK_EC:
+		return objs[0], pkcs11.CKM_ECDSA, nil
+	default:
+		return 0, 0, fmt.Errorf("pkcs11: unsupported key type for label %q", label)
+	}
+}
+
+func (s *PKCS11Signer) fetchPubKey(label string) (crypto.PubKey, error) {
+	template := []*pkcs11.Attribute{
+		pkcs11.NewAttribute(pkcs11.CKA_CLASS, pkcs11.CKO_PUBLIC_KEY),
+		pkcs11.NewAttribute(pkcs11.CKA_LABEL, label),
+	}
+	if err := s.ctx.FindObjectsInit(s.session, template); err != nil {
+		return nil, fmt.Errorf("pkcs11: find public key init: %w", err)
+	}
+	defer s.ctx.FindObjectsFinal(s.session)
+
+	objs, _, err := s.ctx.FindObjects(s.session, 1)
+	if err != nil {
+		return nil, fmt.Errorf("pkcs11: find public key: %w", err)
+	}
+	if len(objs) == 0 {
+		return nil, fmt.Errorf("pkcs11: no public key found with label %q", label)
+	}
+
+	attrs, err := s.ctx.GetAttributeValue(s.session, objs[0], []*pkcs11.Attribute{
+		pkcs11.NewAttribute(pkcs11.CKA_EC_POINT, nil),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("pkcs11: get public key value: %w", err)
+	}
+
+	// CKA_EC_POINT is DER: an OCTET STRING wrapping the actual EC point, not
+	// the point itself.
+	var point []byte
+	if _, err := asn1.Unmarshal(attrs[0].Value, &point); err != nil {
+		return nil, fmt.Errorf("pkcs11: unwrap CKA_EC_POINT: %w", err)
+	}
+
+	switch s.mechanism {
+	case pkcs11.CKM_EDDSA:
+		if len(point) != ed25519.PubKeyEd25519Size {
+			return nil, fmt.Errorf("pkcs11: unexpected ed25519 public key length %d", len(point))
+		}
+		var pubKey ed25519.PubKeyEd25519
+		copy(pubKey[:], point)
+		return pubKey, nil
+	case pkcs11.CKM_ECDSA:
+		return secp256k1PubKeyFromUncompressedPoint(point)
+	default:
+		return nil, fmt.Errorf("pkcs11: unsupported mechanism %d for label %q", s.mechanism, label)
+	}
+}
+
+// GetPubKey implements SignerBackend.
+func (s *PKCS11Signer) GetPubKey() (crypto.PubKey, error) {
+	return s.pubKey, nil
+}
+
+// SignVote implements SignerBackend.
+func (s *PKCS11Signer) SignVote(chainID string, vote *types.Vote) error {
+	sig, err := s.sign(vote.SignBytes(chainID))
+	if err != nil {
+		return err
+	}
+	vote.Signature = sig
+	return nil
+}
+
+// SignProposal implements SignerBackend.
+func (s *PKCS11Signer) SignProposal(chainID string, proposal *types.Proposal) error {
+	sig, err := s.sign(proposal.SignBytes(chainID))
+	if err != nil {
+		return err
+	}
+	proposal.Signature = sig
+	return nil
+}
+
+func (s *PKCS11Signer) sign(signBytes []byte) ([]byte, error) {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+
+	mechanism := []*pkcs11.Mechanism{pkcs11.NewMechanism(s.mechanism, nil)}
+	if err := s.ctx.SignInit(s.session, mechanism, s.privateKey); err != nil {
+		return nil, fmt.Errorf("pkcs11: sign init: %w", err)
+	}
+
+	sig, err := s.ctx.Sign(s.session, signBytes)
+	if err != nil {
+		return nil, fmt.Errorf("pkcs11: sign: %w", err)
+	}
+
+	return sig, nil
+}
+
+// Ping implements SignerBackend. A GetSessionInfo round-trip is used to verify
+// the session is still open and logged in as a read/write user.
+func (s *PKCS11Signer) Ping() error {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+
+	info, err := s.ctx.GetSessionInfo(s.session)
+	if err != nil {
+		return fmt.Errorf("pkcs11: ping: %w", err)
+	}
+	if info.State != pkcs11.CKS_RW_USER_FUNCTIONS {
+		return fmt.Errorf("pkcs11: ping: session no longer authenticated")
+	}
+
+	return nil
+}
@@ -0,0 +1,75 @@
+package privval
+
+import (
+	"crypto/x509/pkix"
+	"encoding/asn1"
+	"encoding/pem"
+	"fmt"
+
+	"github.com/tendermint/tendermint/crypto"
+	"github.com/tendermint/tendermint/crypto/secp256k1"
+)
+
+// secp256k1PubKeyFromBytes wraps a 33-byte compressed secp256k1 public key
+// in Tendermint's pub key type; input of any other length is rejected.
+func secp256k1PubKeyFromBytes(b []byte) (crypto.PubKey, error) {
+	if len(b) != secp256k1.PubKeySecp256k1Size {
+		return nil, fmt.Errorf("kms: unexpected public key length %d", len(b))
+	}
+	var pubKey secp256k1.PubKeySecp256k1
+	copy(pubKey[:], b)
+	return pubKey, nil
+}
+
+// parsePEMSecp256k1PubKey decodes a PEM-encoded SubjectPublicKeyInfo, as
+// returned by GCP KMS's and Vault Transit's GetPublicKey for a secp256k1
+// key, into Tendermint's secp256k1 pub key type.
+//
+// crypto/x509.ParsePKIXPublicKey cannot be used here: its curve recognition
+// is hardcoded to NIST P-224/256/384/521 and has no secp256k1 OID, so it
+// rejects exactly the keys GCP/Vault hand back. The wire format is ordinary
+// SubjectPublicKeyInfo otherwise, so we unmarshal it ourselves and treat the
+// BIT STRING payload as a raw, uncompressed secp256k1 point — safe here
+// because we only ever ask these APIs for a secp256k1 key in the first
+// place.
+func parsePEMSecp256k1PubKey(pemBytes []byte) (crypto.PubKey, error) {
+	block, _ := pem.Decode(pemBytes)
+	if block == nil {
+		return nil, fmt.Errorf("kms: failed to decode PEM public key")
+	}
+
+	var spki struct {
+		Algorithm pkix.AlgorithmIdentifier
+		PublicKey asn1.BitString
+	}
+	if _, err := asn1.Unmarshal(block.Bytes, &spki); err != nil {
+		return nil, fmt.Errorf("kms: parse SubjectPublicKeyInfo: %w", err)
+	}
+
+	return secp256k1PubKeyFromUncompressedPoint(spki.PublicKey.RightAlign())
+}
+
+// secp256k1PubKeyFromUncompressedPoint converts an uncompressed SEC1 point
+// (0x04 || X || Y, 65 bytes) into Tendermint's 33-byte compressed secp256k1
+// public key. This needs no curve arithmetic: the compression prefix is
+// just the parity of Y, which is the low bit of its last (least
+// significant) byte in the point's big-endian encoding.
+func secp256k1PubKeyFromUncompressedPoint(point []byte) (crypto.PubKey, error) {
+	const uncompressedLen = 1 + 32 + 32
+	if len(point) != uncompressedLen || point[0] != 0x04 {
+		return nil, fmt.Errorf("kms: expected a 65-byte uncompressed secp256k1 point, got %d bytes", len(point))
+	}
+
+	x := point[1:33]
+	y := point[33:65]
+
+	compressed := make([]byte, secp256k1.PubKeySecp256k1Size)
+	if y[31]&1 == 0 {
+		compressed[0] = 0x02
+	} else {
+		compressed[0] = 0x03
+	}
+	copy(compressed[1:], x)
+
+	return secp256k1PubKeyFromBytes(compressed)
+}
@@ -0,0 +1,34 @@
+package privval
+
+import (
+	"github.com/tendermint/tendermint/crypto"
+	"github.com/tendermint/tendermint/types"
+)
+
+// SignerBackend defines the minimal set of operations a validator needs from
+// whatever is holding its consensus private key: produce signatures for
+// votes and proposals, report the associated public key, and report on its
+// own liveness.
+//
+// SignerListenerEndpoint is the original backend: a socket-connected
+// external process speaking the RemoteSignerMsg protocol. PKCS11Signer and
+// KMSSigner are alternative backends for operators who want to keep key
+// material in an HSM or a cloud KMS instead of running a separate priv_val
+// process.
+type SignerBackend interface {
+	// GetPubKey returns the public key of the backend's private key.
+	GetPubKey() (crypto.PubKey, error)
+
+	// SignVote requests a signature for the given vote and, on success,
+	// sets vote.Signature.
+	SignVote(chainID string, vote *types.Vote) error
+
+	// SignProposal requests a signature for the given proposal and, on
+	// success, sets proposal.Signature.
+	SignProposal(chainID string, proposal *types.Proposal) error
+
+	// Ping checks that the backend is reachable and able to sign.
+	Ping() error
+}
+
+var _ SignerBackend = (*SignerListenerEndpoint)(nil)
@@ -0,0 +1,93 @@
+package privval
+
+import (
+	"sync"
+	"time"
+
+	"github.com/tendermint/tendermint/crypto"
+	cmn "github.com/tendermint/tendermint/libs/common"
+	"github.com/tendermint/tendermint/libs/log"
+	"github.com/tendermint/tendermint/types"
+)
+
+// SignerBackendEndpoint runs the liveness checks that SignerListenerEndpoint
+// runs for a socket connection, but against any SignerBackend. This is the
+// entry point for HSM (PKCS11Signer) and cloud KMS (KMSSigner) backends,
+// which have no listener/conn to accept and only need to be periodically
+// pinged.
+type SignerBackendEndpoint struct {
+	cmn.BaseService
+
+	backend SignerBackend
+
+	cancelPingCh    chan struct{}
+	pingTicker      *time.Ticker
+	pingWg          sync.WaitGroup
+	heartbeatPeriod time.Duration
+}
+
+// NewSignerBackendEndpoint returns an instance of SignerBackendEndpoint
+// wrapping the given backend.
+func NewSignerBackendEndpoint(logger log.Logger, backend SignerBackend) *SignerBackendEndpoint {
+	se := &SignerBackendEndpoint{
+		backend:         backend,
+		heartbeatPeriod: heartbeatPeriod,
+	}
+
+	se.BaseService = *cmn.NewBaseService(logger, "SignerBackendEndpoint", se)
+
+	return se
+}
+
+// OnStart implements cmn.Service.
+func (se *SignerBackendEndpoint) OnStart() error {
+	if err := se.backend.Ping(); err != nil {
+		se.Logger.Error("OnStart", "err", err)
+		return err
+	}
+
+	se.cancelPingCh = make(chan struct{}, 1)
+	se.pingTicker = time.NewTicker(se.heartbeatPeriod)
+
+	se.pingWg.Add(1)
+	go func() {
+		defer se.pingWg.Done()
+		for {
+			select {
+			case <-se.pingTicker.C:
+				if err := se.backend.Ping(); err != nil {
+					se.Logger.Error("Ping", "err", err)
+				}
+			case <-se.cancelPingCh:
+				se.pingTicker.Stop()
+				return
+			}
+		}
+	}()
+
+	return nil
+}
+
+// OnStop implements cmn.Service.
+func (se *SignerBackendEndpoint) OnStop() {
+	if se.cancelPingCh != nil {
+		close(se.cancelPingCh)
+		se.cancelPingCh = nil
+	}
+	se.pingWg.Wait()
+}
+
+// GetPubKey delegates to the wrapped backend.
+func (se *SignerBackendEndpoint) GetPubKey() (crypto.PubKey, error) {
+	return se.backend.GetPubKey()
+}
+
+// SignVote delegates to the wrapped backend.
+func (se *SignerBackendEndpoint) SignVote(chainID string, vote *types.Vote) error {
+	return se.backend.SignVote(chainID, vote)
+}
+
+// SignProposal delegates to the wrapped backend.
+func (se *SignerBackendEndpoint) SignProposal(chainID string, proposal *types.Proposal) error {
+	return se.backend.SignProposal(chainID, proposal)
+}
@@ -0,0 +1,134 @@
+package privval
+
+import (
+	"context"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// withinTimeout fails the test if fn doesn't return within d, so a regression
+// that reintroduces a blocking wait fails the test instead of hanging the
+// test run.
+func withinTimeout(t *testing.T, d time.Duration, fn func() error) error {
+	t.Helper()
+
+	errCh := make(chan error, 1)
+	go func() { errCh <- fn() }()
+
+	select {
+	case err := <-errCh:
+		return err
+	case <-time.After(d):
+		t.Fatalf("sendRequestContext did not return within %s", d)
+		return nil
+	}
+}
+
+func TestSendRequestContextDeadlineExceeded(t *testing.T) {
+	local, remote := net.Pipe()
+	defer remote.Close()
+	sc := &signerConn{id: 1, conn: local}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+
+	// Nobody reads from remote, so the write inside sendRequestContext blocks
+	// until ctx's deadline forces the conn's own deadline and unblocks it.
+	err := withinTimeout(t, time.Second, func() error {
+		_, err := sc.sendRequestContext(ctx, &PingRequest{})
+		return err
+	})
+
+	assert.Error(t, err)
+}
+
+func TestSendRequestContextCancellation(t *testing.T) {
+	local, remote := net.Pipe()
+	defer remote.Close()
+	sc := &signerConn{id: 1, conn: local}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	go func() {
+		time.Sleep(20 * time.Millisecond)
+		cancel()
+	}()
+
+	// ctx carries no deadline, so sendRequestContext relies on its watcher
+	// goroutine forcing the conn's deadline to "now" on cancellation.
+	err := withinTimeout(t, time.Second, func() error {
+		_, err := sc.sendRequestContext(ctx, &PingRequest{})
+		return err
+	})
+
+	assert.Error(t, err)
+	assert.Equal(t, context.Canceled, ctx.Err())
+}
+
+func TestSendRequestContextSucceedsBeforeDeadline(t *testing.T) {
+	local, remote := net.Pipe()
+	defer remote.Close()
+	sc := &signerConn{id: 1, conn: local}
+
+	go func() {
+		_, _ = readMessage(remote)
+		_ = writeMessage(remote, &PingResponse{})
+	}()
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+
+	res, err := sc.sendRequestContext(ctx, &PingRequest{})
+
+	assert.NoError(t, err)
+	assert.IsType(t, &PingResponse{}, res)
+}
+
+// TestSendRequestContextWatcherJoinedBeforeReturn guards against the
+// watcher goroutine outliving sendRequestContext: if ctx.Done() fires at
+// essentially the same instant the request completes successfully, Go's
+// select can still pick the ctx.Done() branch after sc.mtx has been
+// unlocked, calling SetDeadline(time.Now()) on sc.conn just as the next
+// caller acquires it and failing that unrelated call instantly. Racing
+// cancellation against a successful response many times and then reusing
+// the conn with a fresh, deadline-free context would catch a reintroduced
+// version of that race.
+func TestSendRequestContextWatcherJoinedBeforeReturn(t *testing.T) {
+	local, remote := net.Pipe()
+	defer remote.Close()
+	sc := &signerConn{id: 1, conn: local}
+
+	for i := 0; i < 50; i++ {
+		ctx, cancel := context.WithCancel(context.Background())
+		responded := make(chan struct{})
+		go func() {
+			_, _ = readMessage(remote)
+			_ = writeMessage(remote, &PingResponse{})
+			close(responded)
+		}()
+		go func() {
+			<-responded
+			cancel()
+		}()
+
+		_, _ = sc.sendRequestContext(ctx, &PingRequest{})
+		<-responded
+		cancel()
+
+		peerDone := make(chan struct{})
+		go func() {
+			_, _ = readMessage(remote)
+			_ = writeMessage(remote, &PingResponse{})
+			close(peerDone)
+		}()
+
+		res, err := sc.sendRequestContext(context.Background(), &PingRequest{})
+		<-peerDone
+
+		require.NoError(t, err, "iteration %d: conn left with a stray deadline", i)
+		assert.IsType(t, &PingResponse{}, res)
+	}
+}
@@ -1,24 +1,62 @@
 package privval
 
 import (
+	"context"
 	"fmt"
 	"net"
 	"sync"
 	"time"
 
+	"github.com/tendermint/tendermint/crypto"
 	cmn "github.com/tendermint/tendermint/libs/common"
 	"github.com/tendermint/tendermint/libs/log"
+	"github.com/tendermint/tendermint/types"
 )
 
 const (
 	defaultHeartbeatSeconds = 2
 	defaultMaxDialRetries   = 10
+	defaultMaxConnections   = 1
+	defaultTimeoutSeconds   = 3
 )
 
 var (
 	heartbeatPeriod = time.Second * defaultHeartbeatSeconds
+	defaultTimeout  = time.Second * defaultTimeoutSeconds
 )
 
+// ConnectionState describes a transition in the life cycle of one of
+// SignerListenerEndpoint's signer connections.
+type ConnectionState int
+
+const (
+	// ConnectionConnected is emitted when a new signer connection is accepted.
+	ConnectionConnected ConnectionState = iota
+	// ConnectionDisconnected is emitted when a signer connection is closed,
+	// whether because it failed a ping/request or the remote end hung up.
+	ConnectionDisconnected
+	// ConnectionPromoted is emitted when a standby connection is promoted to
+	// primary after the previous primary was disconnected.
+	ConnectionPromoted
+)
+
+func (s ConnectionState) String() string {
+	switch s {
+	case ConnectionConnected:
+		return "connected"
+	case ConnectionDisconnected:
+		return "disconnected"
+	case ConnectionPromoted:
+		return "promoted"
+	default:
+		return "unknown"
+	}
+}
+
+// ConnectionStateCallback is invoked whenever one of the endpoint's signer
+// connections changes state. connID identifies the connection across calls.
+type ConnectionStateCallback func(connID int, state ConnectionState)
+
 // SignerValidatorEndpointOption sets an optional parameter on the SocketVal.
 type SignerValidatorEndpointOption func(*SignerListenerEndpoint)
 
@@ -28,28 +66,147 @@ func SignerValidatorEndpointSetHeartbeat(period time.Duration) SignerValidatorEn
 	return func(sc *SignerListenerEndpoint) { sc.heartbeatPeriod = period }
 }
 
-// TODO: Add a type for SignerEndpoints
-// getConnection
-// connect
-// read
-// write
-// close
+// SignerValidatorEndpointSetMaxConnections sets the number of concurrent
+// signer connections the endpoint will accept and keep alive: one primary
+// plus n-1 standbys that SendRequest will fail over to. The default is 1
+// (no standbys), matching the original single-connection behaviour.
+func SignerValidatorEndpointSetMaxConnections(n int) SignerValidatorEndpointOption {
+	return func(sc *SignerListenerEndpoint) { sc.maxConnections = n }
+}
+
+// SignerValidatorEndpointSetConnectionStateCallback registers a callback
+// invoked on every connection state transition (connected, disconnected,
+// promoted to primary). It is called synchronously from the endpoint's
+// internal goroutines and from the goroutine of a failing SendRequest call,
+// so it should not block.
+func SignerValidatorEndpointSetConnectionStateCallback(cb ConnectionStateCallback) SignerValidatorEndpointOption {
+	return func(sc *SignerListenerEndpoint) { sc.connStateCB = cb }
+}
+
+// SignerValidatorEndpointSetTimeout sets the default per-request deadline
+// used by SendRequest (via SendRequestContext) when the caller doesn't
+// supply its own context.
+func SignerValidatorEndpointSetTimeout(timeout time.Duration) SignerValidatorEndpointOption {
+	return func(sc *SignerListenerEndpoint) { sc.timeout = timeout }
+}
+
+// SignerValidatorEndpointSetMetrics sets the metrics the endpoint reports
+// to. The default, if this option is not used, is NopMetrics().
+func SignerValidatorEndpointSetMetrics(metrics *Metrics) SignerValidatorEndpointOption {
+	return func(sc *SignerListenerEndpoint) { sc.metrics = metrics }
+}
+
+// signerConn wraps a single accepted net.Conn. Its own mutex serializes the
+// write+read pair that make up one request/response round trip on that
+// physical connection, independent of every other signerConn the endpoint
+// is holding.
+type signerConn struct {
+	id   int
+	conn net.Conn
+	mtx  sync.Mutex
+}
+
+func (sc *signerConn) sendRequest(request RemoteSignerMsg) (RemoteSignerMsg, error) {
+	return sc.sendRequestContext(context.Background(), request)
+}
+
+// sendRequestContext performs one write+response round trip on sc, honoring
+// ctx's deadline and cancellation. The conn's read/write deadlines are
+// derived from ctx so that a stalled remote signer unblocks sc.mtx instead of
+// holding it indefinitely; if ctx carries no deadline, a watcher goroutine
+// forces the conn's deadline to "now" on cancellation to the same effect.
+func (sc *signerConn) sendRequestContext(ctx context.Context, request RemoteSignerMsg) (RemoteSignerMsg, error) {
+	sc.mtx.Lock()
+	defer sc.mtx.Unlock()
+
+	if deadline, ok := ctx.Deadline(); ok {
+		if err := sc.conn.SetDeadline(deadline); err != nil {
+			return nil, err
+		}
+		defer sc.conn.SetDeadline(time.Time{})
+	}
+
+	// done tells the watcher goroutine the request is over; watcherDone is
+	// closed by the goroutine itself once it has acted on that (or on ctx
+	// cancellation) and is waited on below so the goroutine can never call
+	// SetDeadline on sc.conn after this function has reset the deadline and
+	// released sc.mtx for the next caller.
+	done := make(chan struct{})
+	watcherDone := make(chan struct{})
+	go func() {
+		defer close(watcherDone)
+		select {
+		case <-ctx.Done():
+			_ = sc.conn.SetDeadline(time.Now())
+		case <-done:
+		}
+	}()
+	defer func() {
+		close(done)
+		<-watcherDone
+		_ = sc.conn.SetDeadline(time.Time{})
+	}()
+
+	if err := writeMessage(sc.conn, request); err != nil {
+		if ctx.Err() != nil {
+			return nil, ctx.Err()
+		}
+		return nil, err
+	}
+
+	res, err := readMessage(sc.conn)
+	if err != nil {
+		if ctx.Err() != nil {
+			return nil, ctx.Err()
+		}
+		return nil, err
+	}
+
+	return res, nil
+}
+
+func (sc *signerConn) close() error {
+	return sc.conn.Close()
+}
 
 // TODO: Fix comments
 // SocketVal implements PrivValidator.
 // It listens for an external process to dial in and uses
 // the socket to request signatures.
+//
+// It can hold more than one concurrent signer connection (an active primary
+// plus standbys) so that a ping failure or send timeout on the primary fails
+// over to an already-connected standby instead of blocking consensus while a
+// new process dials in.
 type SignerListenerEndpoint struct {
 	cmn.BaseService
 
-	mtx      sync.Mutex
-	listener net.Listener
-	conn     net.Conn
+	listener          net.Listener
+	closeListenerOnce sync.Once
+
+	connsMtx   sync.RWMutex
+	conns      []*signerConn
+	primary    int // id of the current primary connection, or -1 if none
+	nextConnID int
+
+	maxConnections int
+	connStateCB    ConnectionStateCallback
+
+	eventCh chan Event
+	metrics *Metrics
+
+	acceptCancelCh chan struct{}
+	acceptWg       sync.WaitGroup
 
 	// ping
 	cancelPingCh    chan struct{}
 	pingTicker      *time.Ticker
+	pingWg          sync.WaitGroup
 	heartbeatPeriod time.Duration
+
+	// timeout bounds how long a single SendRequest round trip may take
+	// before SendRequestContext gives up and returns.
+	timeout time.Duration
 }
 
 // NewSignerListenerEndpoint returns an instance of SignerListenerEndpoint.
@@ -57,6 +214,11 @@ func NewSignerListenerEndpoint(logger log.Logger, listener net.Listener) *Signer
 	sc := &SignerListenerEndpoint{
 		listener:        listener,
 		heartbeatPeriod: heartbeatPeriod,
+		maxConnections:  defaultMaxConnections,
+		timeout:         defaultTimeout,
+		primary:         -1,
+		eventCh:         make(chan Event, defaultEventBacklog),
+		metrics:         NopMetrics(),
 	}
 
 	sc.BaseService = *cmn.NewBaseService(logger, "SignerListenerEndpoint", sc)
@@ -66,9 +228,7 @@ func NewSignerListenerEndpoint(logger log.Logger, listener net.Listener) *Signer
 
 // OnStart implements cmn.Service.
 func (ve *SignerListenerEndpoint) OnStart() error {
-	closed, err := ve.connect()
-	// TODO: Improve. Connection state should be kept in a variable
-
+	closed, err := ve.acceptConnection()
 	if err != nil {
 		ve.Logger.Error("OnStart", "err", err)
 		return err
@@ -78,40 +238,15 @@ func (ve *SignerListenerEndpoint) OnStart() error {
 		return fmt.Errorf("listener is closed")
 	}
 
-	// Start a routine to keep the connection alive
+	ve.acceptCancelCh = make(chan struct{})
+	ve.acceptWg.Add(1)
+	go ve.acceptLoop()
+
+	// Start a routine to keep the connections alive
 	ve.cancelPingCh = make(chan struct{}, 1)
 	ve.pingTicker = time.NewTicker(ve.heartbeatPeriod)
-
-	// TODO: Move subroutine to another place?
-	go func() {
-		for {
-			select {
-			case <-ve.pingTicker.C:
-				err := ve.ping()
-				if err != nil {
-					ve.Logger.Error("Ping", "err", err)
-					if err == ErrUnexpectedResponse {
-						return
-					}
-
-					closed, err := ve.connect()
-					if err != nil {
-						ve.Logger.Error("Reconnecting to remote signer failed", "err", err)
-						continue
-					}
-					if closed {
-						ve.Logger.Info("listener is closing")
-						return
-					}
-
-					ve.Logger.Info("Re-created connection to remote signer", "impl", ve)
-				}
-			case <-ve.cancelPingCh:
-				ve.pingTicker.Stop()
-				return
-			}
-		}
-	}()
+	ve.pingWg.Add(1)
+	go ve.pingLoop()
 
 	return nil
 }
@@ -122,52 +257,333 @@ func (ve *SignerListenerEndpoint) OnStop() {
 		close(ve.cancelPingCh)
 		ve.cancelPingCh = nil
 	}
+	ve.pingWg.Wait()
+
+	// Closing the listener unblocks any goroutine parked in Accept() inside
+	// acceptLoop. This has to happen before acceptWg.Wait(): acceptCancelCh
+	// is only checked between accepts, not while one is in flight, and
+	// acceptLoop sits in Accept() whenever the endpoint is below
+	// maxConnections standbys — the normal state right after any disconnect,
+	// or simply before an HA standby has dialed in. Waiting on acceptWg
+	// first would hang forever in that case. acceptCancelCh is closed before
+	// the listener so that the Accept() error this causes is seen as shutdown.
+	if ve.acceptCancelCh != nil {
+		close(ve.acceptCancelCh)
+	}
+
+	ve.closeListener()
+
+	if ve.acceptCancelCh != nil {
+		ve.acceptWg.Wait()
+		ve.acceptCancelCh = nil
+	}
+
 	_ = ve.Close()
 }
 
-// Close closes the underlying net.Conn.
+// closeListener closes the listener at most once, whether reached via
+// OnStop or a direct call to Close().
+func (ve *SignerListenerEndpoint) closeListener() {
+	ve.closeListenerOnce.Do(func() {
+		if ve.listener == nil {
+			return
+		}
+		if err := ve.listener.Close(); err != nil {
+			ve.Logger.Error("Closing Listener", "err", err)
+		}
+	})
+}
+
+// Close closes every underlying net.Conn and the listener.
 func (ve *SignerListenerEndpoint) Close() error {
-	ve.mtx.Lock()
-	defer ve.mtx.Unlock()
+	ve.closeListener()
+
+	ve.connsMtx.Lock()
+	defer ve.connsMtx.Unlock()
 
-	if ve.conn != nil {
-		if err := ve.conn.Close(); err != nil {
-			ve.Logger.Error("Closing connection", "err", err)
-			return err
+	for _, sc := range ve.conns {
+		if err := sc.close(); err != nil {
+			ve.Logger.Error("Closing connection", "err", err, "connID", sc.id)
 		}
-		ve.conn = nil
 	}
+	ve.conns = nil
+	ve.primary = -1
+	ve.metrics.ConnectionState.Set(0)
 
-	if ve.listener != nil {
-		if err := ve.listener.Close(); err != nil {
-			ve.Logger.Error("Closing Listener", "err", err)
-			return err
+	return nil
+}
+
+// SendRequest sends a request and waits for a response, bounded by the
+// endpoint's default timeout (see SignerValidatorEndpointSetTimeout). It
+// delegates to SendRequestContext.
+func (ve *SignerListenerEndpoint) SendRequest(request RemoteSignerMsg) (RemoteSignerMsg, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), ve.timeout)
+	defer cancel()
+
+	return ve.SendRequestContext(ctx, request)
+}
+
+// SendRequestContext sends a request and waits for a response, using ctx's
+// deadline (if any) as the per-connection read/write deadline and returning
+// promptly if ctx is cancelled.
+//
+// PubKeyRequest is idempotent and read-only, so it is dispatched to whatever
+// connection is least likely to be busy with consensus traffic (preferring a
+// standby over the primary) rather than forced through the same connection
+// SignVote/SignProposal use. Every other request type is routed to the
+// primary connection, with automatic failover to a healthy standby if the
+// primary errors out.
+func (ve *SignerListenerEndpoint) SendRequestContext(ctx context.Context, request RemoteSignerMsg) (RemoteSignerMsg, error) {
+	if _, ok := request.(*PubKeyRequest); ok {
+		return ve.sendIdempotent(ctx, request)
+	}
+	return ve.sendToPrimary(ctx, request)
+}
+
+// sendToPrimary sends request to the primary connection, failing over to
+// whatever connection is promoted to primary next on error, evicting each
+// failed connection in turn, until one succeeds, ctx is done, or no primary
+// connection remains.
+func (ve *SignerListenerEndpoint) sendToPrimary(ctx context.Context, request RemoteSignerMsg) (RemoteSignerMsg, error) {
+	var lastErr error
+	for {
+		sc := ve.primaryConn()
+		if sc == nil {
+			if lastErr == nil {
+				lastErr = fmt.Errorf("endpoint is not connected")
+			}
+			return nil, lastErr
+		}
+
+		res, err := ve.sendAndRecord(ctx, sc, request)
+		if err == nil {
+			return res, nil
+		}
+
+		ve.Logger.Error("SendRequest: primary connection failed, failing over", "err", err, "connID", sc.id)
+		ve.markUnhealthy(sc)
+		lastErr = err
+
+		if ctx.Err() != nil {
+			return nil, err
 		}
 	}
+}
 
+// sendIdempotent dispatches a read-only request to a healthy connection,
+// preferring a standby so it does not compete with SignVote/SignProposal
+// traffic on the primary.
+func (ve *SignerListenerEndpoint) sendIdempotent(ctx context.Context, request RemoteSignerMsg) (RemoteSignerMsg, error) {
+	sc := ve.standbyConn()
+	if sc == nil {
+		sc = ve.primaryConn()
+	}
+	if sc == nil {
+		return nil, fmt.Errorf("endpoint is not connected")
+	}
+
+	res, err := ve.sendAndRecord(ctx, sc, request)
+	if err != nil {
+		ve.markUnhealthy(sc)
+	}
+	return res, err
+}
+
+// sendAndRecord performs one request/response round trip on sc, recording
+// its latency and (on error) its error class, shared by sendToPrimary's
+// failover loop and sendIdempotent so every attempt is accounted for in
+// metrics, not just the first.
+func (ve *SignerListenerEndpoint) sendAndRecord(ctx context.Context, sc *signerConn, request RemoteSignerMsg) (RemoteSignerMsg, error) {
+	start := time.Now()
+	res, err := sc.sendRequestContext(ctx, request)
+	ve.metrics.SendRequestLatency.With("message_type", messageType(request)).Observe(time.Since(start).Seconds())
+	if err != nil {
+		ve.metrics.SignerErrors.With("error_class", errorClass(err)).Add(1)
+	}
+	return res, err
+}
+
+func (ve *SignerListenerEndpoint) primaryConn() *signerConn {
+	ve.connsMtx.RLock()
+	defer ve.connsMtx.RUnlock()
+
+	for _, sc := range ve.conns {
+		if sc.id == ve.primary {
+			return sc
+		}
+	}
 	return nil
 }
 
-// SendRequest sends a request and waits for a response
-func (ve *SignerListenerEndpoint) SendRequest(request RemoteSignerMsg) (RemoteSignerMsg, error) {
-	ve.mtx.Lock()
-	defer ve.mtx.Unlock()
+func (ve *SignerListenerEndpoint) standbyConn() *signerConn {
+	ve.connsMtx.RLock()
+	defer ve.connsMtx.RUnlock()
 
-	err := ve.writeMessage(request)
+	for _, sc := range ve.conns {
+		if sc.id != ve.primary {
+			return sc
+		}
+	}
+	return nil
+}
+
+// markUnhealthy evicts a failed connection from ve.conns entirely (there is
+// no separate "unhealthy but kept around" state: every connection still in
+// ve.conns is assumed healthy), promotes a standby to primary if the failed
+// connection was the primary, and frees a slot for the accept loop to fill
+// with a replacement.
+func (ve *SignerListenerEndpoint) markUnhealthy(failed *signerConn) {
+	ve.connsMtx.Lock()
+
+	wasPrimary := failed.id == ve.primary
+	remaining := ve.conns[:0]
+	for _, sc := range ve.conns {
+		if sc.id != failed.id {
+			remaining = append(remaining, sc)
+		}
+	}
+	ve.conns = remaining
+
+	var promoted *signerConn
+	if wasPrimary {
+		ve.primary = -1
+		if len(ve.conns) > 0 {
+			promoted = ve.conns[0]
+			ve.primary = promoted.id
+		}
+	}
+	ve.connsMtx.Unlock()
+
+	_ = failed.close()
+	ve.notifyConnState(failed.id, ConnectionDisconnected, EventDisconnected, nil)
+	if promoted != nil {
+		ve.notifyConnState(promoted.id, ConnectionPromoted, EventPromoted, nil)
+	}
+
+	if ve.primaryConn() == nil {
+		ve.metrics.ConnectionState.Set(0)
+	}
+}
+
+// notifyConnState drives both the legacy ConnectionStateCallback (kept for
+// callers registered via SignerValidatorEndpointSetConnectionStateCallback)
+// and the newer Events() channel, which
+// also carries PingTimeout/ReconnectFailed occurrences the callback never
+// saw.
+func (ve *SignerListenerEndpoint) notifyConnState(connID int, state ConnectionState, evType EventType, err error) {
+	if ve.connStateCB != nil {
+		ve.connStateCB(connID, state)
+	}
+	ve.emitEvent(Event{ConnID: connID, Type: evType, Err: err})
+}
+
+func (ve *SignerListenerEndpoint) pingLoop() {
+	defer ve.pingWg.Done()
+
+	for {
+		select {
+		case <-ve.pingTicker.C:
+			ve.pingAll()
+		case <-ve.cancelPingCh:
+			ve.pingTicker.Stop()
+			return
+		}
+	}
+}
+
+func (ve *SignerListenerEndpoint) pingAll() {
+	ve.connsMtx.RLock()
+	conns := make([]*signerConn, len(ve.conns))
+	copy(conns, ve.conns)
+	ve.connsMtx.RUnlock()
+
+	for _, sc := range conns {
+		start := time.Now()
+		ctx, cancel := context.WithTimeout(context.Background(), ve.heartbeatPeriod)
+		response, err := sc.sendRequestContext(ctx, &PingRequest{})
+		cancel()
+		ve.metrics.PingTime.Observe(time.Since(start).Seconds())
+
+		if err == nil {
+			if _, ok := response.(*PingResponse); ok {
+				continue
+			}
+			err = ErrUnexpectedResponse
+		}
+
+		ve.Logger.Error("Ping", "err", err, "connID", sc.id)
+		ve.metrics.SignerErrors.With("error_class", errorClass(err)).Add(1)
+		if err == context.DeadlineExceeded || hasCause(err, ErrListenerTimeout) {
+			ve.emitEvent(Event{ConnID: sc.id, Type: EventPingTimeout, Err: err})
+		}
+		ve.markUnhealthy(sc)
+	}
+}
+
+// GetPubKey implements SignerBackend. It requests the public key from the
+// remote signer over the socket connection.
+func (ve *SignerListenerEndpoint) GetPubKey() (crypto.PubKey, error) {
+	response, err := ve.SendRequest(&PubKeyRequest{})
 	if err != nil {
 		return nil, err
 	}
 
-	res, err := ve.readMessage()
+	pubKeyResp, ok := response.(*PubKeyResponse)
+	if !ok {
+		return nil, ErrUnexpectedResponse
+	}
+	if pubKeyResp.Error != nil {
+		return nil, pubKeyResp.Error
+	}
+
+	return pubKeyResp.PubKey, nil
+}
+
+// SignVote implements SignerBackend. It sends the vote to the remote signer
+// and, on success, overwrites vote with the signed vote it returns.
+func (ve *SignerListenerEndpoint) SignVote(chainID string, vote *types.Vote) error {
+	response, err := ve.SendRequest(&SignVoteRequest{Vote: vote})
+	if err != nil {
+		return err
+	}
+
+	signedResp, ok := response.(*SignedVoteResponse)
+	if !ok {
+		return ErrUnexpectedResponse
+	}
+	if signedResp.Error != nil {
+		return signedResp.Error
+	}
+
+	*vote = *signedResp.Vote
+
+	return nil
+}
+
+// SignProposal implements SignerBackend. It sends the proposal to the remote
+// signer and, on success, overwrites proposal with the signed proposal it
+// returns.
+func (ve *SignerListenerEndpoint) SignProposal(chainID string, proposal *types.Proposal) error {
+	response, err := ve.SendRequest(&SignProposalRequest{Proposal: proposal})
 	if err != nil {
-		return nil, err
+		return err
 	}
 
-	return res, nil
+	signedResp, ok := response.(*SignedProposalResponse)
+	if !ok {
+		return ErrUnexpectedResponse
+	}
+	if signedResp.Error != nil {
+		return signedResp.Error
+	}
+
+	*proposal = *signedResp.Proposal
+
+	return nil
 }
 
-// Ping is used to check connection health.
-func (ve *SignerListenerEndpoint) ping() error {
+// Ping implements SignerBackend. It is used to check connection health.
+func (ve *SignerListenerEndpoint) Ping() error {
 	response, err := ve.SendRequest(&PingRequest{})
 
 	if err != nil {
@@ -182,11 +598,9 @@ func (ve *SignerListenerEndpoint) ping() error {
 	return nil
 }
 
-func (ve *SignerListenerEndpoint) readMessage() (msg RemoteSignerMsg, err error) {
-	// TODO: Check connection status
-
+func readMessage(conn net.Conn) (msg RemoteSignerMsg, err error) {
 	const maxRemoteSignerMsgSize = 1024 * 10
-	_, err = cdc.UnmarshalBinaryLengthPrefixedReader(ve.conn, &msg, maxRemoteSignerMsgSize)
+	_, err = cdc.UnmarshalBinaryLengthPrefixedReader(conn, &msg, maxRemoteSignerMsgSize)
 	if _, ok := err.(timeoutError); ok {
 		err = cmn.ErrorWrap(ErrListenerTimeout, err.Error())
 	}
@@ -194,13 +608,12 @@ func (ve *SignerListenerEndpoint) readMessage() (msg RemoteSignerMsg, err error)
 	return
 }
 
-func (ve *SignerListenerEndpoint) writeMessage(msg RemoteSignerMsg) (err error) {
-	// TODO: Check connection status
-	if ve.conn == nil {
+func writeMessage(conn net.Conn, msg RemoteSignerMsg) (err error) {
+	if conn == nil {
 		return fmt.Errorf("endpoint is not connected")
 	}
 
-	_, err = cdc.MarshalBinaryLengthPrefixedWriter(ve.conn, msg)
+	_, err = cdc.MarshalBinaryLengthPrefixedWriter(conn, msg)
 	if _, ok := err.(timeoutError); ok {
 		err = cmn.ErrorWrap(ErrListenerTimeout, err.Error())
 	}
@@ -208,39 +621,82 @@ func (ve *SignerListenerEndpoint) writeMessage(msg RemoteSignerMsg) (err error)
 	return
 }
 
-// waits to accept and sets a new connection.
-// connection is closed in OnStop.
-// returns true if the listener is closed (ie. it returns a nil conn).
-// TODO: Improve this
-func (ve *SignerListenerEndpoint) connect() (closed bool, err error) {
-	ve.mtx.Lock()
-	defer ve.mtx.Unlock()
+// acceptLoop keeps accepting new signer connections to fill any free slot up
+// to maxConnections, for as long as the endpoint is running. It is how a
+// standby slot is refilled after its connection is promoted, or a
+// replacement connection is established after a failure.
+func (ve *SignerListenerEndpoint) acceptLoop() {
+	defer ve.acceptWg.Done()
+
+	for {
+		select {
+		case <-ve.acceptCancelCh:
+			return
+		default:
+		}
+
+		if ve.connCount() >= ve.maxConnections {
+			select {
+			case <-ve.acceptCancelCh:
+				return
+			case <-time.After(ve.heartbeatPeriod):
+				continue
+			}
+		}
+
+		closed, err := ve.acceptConnection()
+		if err != nil {
+			// Accept() returns an error when the listener is closed, which
+			// is also how shutdown interrupts a blocking accept. Don't
+			// treat that as a reconnect failure.
+			select {
+			case <-ve.acceptCancelCh:
+				return
+			default:
+			}
 
-	// first check if the conn already exists and close it.
-	if ve.conn != nil {
-		if tmpErr := ve.conn.Close(); tmpErr != nil {
-			ve.Logger.Error("error closing socket val connection during connect", "err", tmpErr)
+			ve.Logger.Error("accepting standby signer connection failed", "err", err)
+			ve.emitEvent(Event{Type: EventReconnectFailed, Err: err})
+			continue
+		}
+		if closed {
+			return
 		}
+
+		ve.metrics.ReconnectCount.Add(1)
 	}
+}
+
+func (ve *SignerListenerEndpoint) connCount() int {
+	ve.connsMtx.RLock()
+	defer ve.connsMtx.RUnlock()
+	return len(ve.conns)
+}
 
-	// wait for a new conn
-	ve.conn, err = ve.listener.Accept()
+// acceptConnection waits for and registers a new signer connection. If it is
+// the first connection seen, it becomes the primary. It returns true if the
+// listener has been closed (i.e. Accept returns a nil conn).
+func (ve *SignerListenerEndpoint) acceptConnection() (closed bool, err error) {
+	conn, err := ve.listener.Accept()
 	if err != nil {
 		return false, err
 	}
 
-	// listener is closed
-	if ve.conn == nil {
+	if conn == nil {
 		return true, nil
 	}
 
-	if err != nil {
-		// TODO: This does not belong here... but maybe we need to inform the owner that a connection has been received
-		// failed to fetch the pubkey. close out the connection.
-		if tmpErr := ve.conn.Close(); tmpErr != nil {
-			ve.Logger.Error("error closing connection", "err", tmpErr)
-		}
-		return false, err
+	ve.connsMtx.Lock()
+	ve.nextConnID++
+	sc := &signerConn{id: ve.nextConnID, conn: conn}
+	ve.conns = append(ve.conns, sc)
+	if ve.primary == -1 {
+		ve.primary = sc.id
 	}
+	ve.connsMtx.Unlock()
+
+	ve.notifyConnState(sc.id, ConnectionConnected, EventConnected, nil)
+	ve.metrics.ConnectionState.Set(1)
+
 	return false, nil
 }
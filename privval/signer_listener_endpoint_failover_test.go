@@ -0,0 +1,155 @@
+package privval
+
+import (
+	"context"
+	"net"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/tendermint/tendermint/libs/log"
+)
+
+// newTestEndpoint returns a SignerListenerEndpoint with no listener attached,
+// for tests that manipulate ve.conns directly instead of going through
+// OnStart/acceptLoop.
+func newTestEndpoint() *SignerListenerEndpoint {
+	ve := NewSignerListenerEndpoint(log.TestingLogger(), nil)
+	return ve
+}
+
+// addConn registers a signerConn backed by one end of a net.Pipe and returns
+// both ends so the test can drive the remote side directly.
+func addConn(t *testing.T, ve *SignerListenerEndpoint, id int) (*signerConn, net.Conn) {
+	t.Helper()
+
+	local, remote := net.Pipe()
+	sc := &signerConn{id: id, conn: local}
+
+	ve.connsMtx.Lock()
+	ve.conns = append(ve.conns, sc)
+	if ve.primary == -1 {
+		ve.primary = sc.id
+	}
+	ve.connsMtx.Unlock()
+
+	return sc, remote
+}
+
+func TestMarkUnhealthyPromotesStandby(t *testing.T) {
+	ve := newTestEndpoint()
+	ve.primary = -1
+
+	primary, primaryRemote := addConn(t, ve, 1)
+	defer primaryRemote.Close()
+	standby, standbyRemote := addConn(t, ve, 2)
+	defer standbyRemote.Close()
+
+	require.Equal(t, primary.id, ve.primary)
+
+	ve.markUnhealthy(primary)
+
+	assert.Equal(t, standby.id, ve.primary)
+	assert.Nil(t, ve.standbyConn())
+	assert.Equal(t, standby, ve.primaryConn())
+}
+
+func TestMarkUnhealthyNoStandbyLeavesNoPrimary(t *testing.T) {
+	ve := newTestEndpoint()
+	ve.primary = -1
+
+	primary, primaryRemote := addConn(t, ve, 1)
+	defer primaryRemote.Close()
+
+	ve.markUnhealthy(primary)
+
+	assert.Equal(t, -1, ve.primary)
+	assert.Nil(t, ve.primaryConn())
+}
+
+func TestMarkUnhealthyStandbyDoesNotDisturbPrimary(t *testing.T) {
+	ve := newTestEndpoint()
+	ve.primary = -1
+
+	primary, primaryRemote := addConn(t, ve, 1)
+	defer primaryRemote.Close()
+	standby, standbyRemote := addConn(t, ve, 2)
+	defer standbyRemote.Close()
+
+	ve.markUnhealthy(standby)
+
+	assert.Equal(t, primary.id, ve.primary)
+	assert.Equal(t, primary, ve.primaryConn())
+	assert.Nil(t, ve.standbyConn())
+}
+
+func TestSendToPrimaryFailsOverToStandby(t *testing.T) {
+	ve := newTestEndpoint()
+	ve.primary = -1
+
+	_, primaryRemote := addConn(t, ve, 1)
+	// Closing the primary's remote end makes any read/write on the local end
+	// fail immediately, simulating a dead signer connection.
+	primaryRemote.Close()
+
+	standby, standbyRemote := addConn(t, ve, 2)
+	defer standbyRemote.Close()
+
+	go func() {
+		_, _ = readMessage(standbyRemote)
+		_ = writeMessage(standbyRemote, &PingResponse{})
+	}()
+
+	res, err := ve.sendToPrimary(context.Background(), &PingRequest{})
+
+	require.NoError(t, err)
+	assert.IsType(t, &PingResponse{}, res)
+	assert.Equal(t, standby.id, ve.primary)
+}
+
+// TestSendToPrimaryRetriesAcrossMultipleDeadConns verifies sendToPrimary
+// keeps failing over past a dead replacement instead of giving up after one
+// retry, and that every failed connection -- not just the first -- is
+// evicted via markUnhealthy so the third one ends up as primary.
+func TestSendToPrimaryRetriesAcrossMultipleDeadConns(t *testing.T) {
+	ve := newTestEndpoint()
+	ve.primary = -1
+
+	_, firstRemote := addConn(t, ve, 1)
+	firstRemote.Close()
+	_, secondRemote := addConn(t, ve, 2)
+	secondRemote.Close()
+	third, thirdRemote := addConn(t, ve, 3)
+	defer thirdRemote.Close()
+
+	go func() {
+		_, _ = readMessage(thirdRemote)
+		_ = writeMessage(thirdRemote, &PingResponse{})
+	}()
+
+	res, err := ve.sendToPrimary(context.Background(), &PingRequest{})
+
+	require.NoError(t, err)
+	assert.IsType(t, &PingResponse{}, res)
+	assert.Equal(t, third.id, ve.primary)
+}
+
+// TestSendToPrimaryAllConnsDead verifies sendToPrimary gives up (rather than
+// looping forever) once every connection has been evicted, returning an
+// error and leaving no primary.
+func TestSendToPrimaryAllConnsDead(t *testing.T) {
+	ve := newTestEndpoint()
+	ve.primary = -1
+
+	_, firstRemote := addConn(t, ve, 1)
+	firstRemote.Close()
+	_, secondRemote := addConn(t, ve, 2)
+	secondRemote.Close()
+
+	_, err := ve.sendToPrimary(context.Background(), &PingRequest{})
+
+	assert.Error(t, err)
+	assert.Equal(t, -1, ve.primary)
+	assert.Nil(t, ve.primaryConn())
+}
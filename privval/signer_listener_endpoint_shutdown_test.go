@@ -0,0 +1,53 @@
+package privval
+
+import (
+	"net"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/tendermint/tendermint/libs/log"
+)
+
+// TestStopUnblocksAcceptLoopBelowCapacity reproduces the scenario this
+// endpoint exists for: maxConnections > 1 and a standby hasn't dialed in yet,
+// so acceptLoop is parked in a blocking Accept() call (not the "at capacity"
+// select branch, which acceptCancelCh already guarded). Stop must still
+// return promptly by closing the listener to unblock that Accept(), rather
+// than waiting on acceptWg first.
+func TestStopUnblocksAcceptLoopBelowCapacity(t *testing.T) {
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+
+	ve := NewSignerListenerEndpoint(log.TestingLogger(), ln)
+	ve.maxConnections = 2
+
+	dialed := make(chan struct{})
+	go func() {
+		conn, err := net.Dial("tcp", ln.Addr().String())
+		if err == nil {
+			defer conn.Close()
+		}
+		close(dialed)
+		// Keep the connection open for the duration of the test so the
+		// endpoint has exactly one (primary) connection and is still below
+		// maxConnections, the state that used to deadlock Stop().
+		time.Sleep(time.Second)
+	}()
+
+	require.NoError(t, ve.Start())
+	<-dialed
+
+	stopped := make(chan struct{})
+	go func() {
+		ve.Stop()
+		close(stopped)
+	}()
+
+	select {
+	case <-stopped:
+	case <-time.After(2 * time.Second):
+		t.Fatal("Stop() did not return: acceptLoop is stuck in a blocking Accept()")
+	}
+}